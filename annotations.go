@@ -0,0 +1,159 @@
+package feedly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const annotationsEndpoint = "annotations"
+
+// Range stores the position of a highlight inside the entry content
+type Range struct {
+	// Start integer the offset of the first character of the highlight.
+	Start int `json:"start"`
+	// End integer the offset following the last character of the highlight.
+	End int `json:"end"`
+}
+
+// Highlight stores the highlight data
+type Highlight struct {
+	// Text string the highlighted text.
+	Text string `json:"text"`
+	// Ranges Optional range array the positions of the highlighted text inside the entry content.
+	Ranges []Range `json:"ranges,omitempty"`
+}
+
+// Annotation stores the annotation data: a note and/or a highlight on an entry
+type Annotation struct {
+	// ID string the annotation id.
+	ID string `json:"id"`
+	// EntryID string the id of the annotated entry.
+	EntryID string `json:"entryId"`
+	// Comment Optional string the note.
+	Comment string `json:"comment,omitempty"`
+	// Highlight Optional highlight object the highlighted text.
+	Highlight *Highlight `json:"highlight,omitempty"`
+	// Created timestamp the timestamp, in ms, when this annotation was created.
+	Created Time `json:"created"`
+	// Updated Optional timestamp the timestamp, in ms, when this annotation was last updated.
+	Updated Time `json:"updated,omitempty"`
+}
+
+// IsNote reports whether the annotation contains a note.
+func (a Annotation) IsNote() bool {
+	return a.Comment != ""
+}
+
+// IsHighlight reports whether the annotation contains a highlight.
+func (a Annotation) IsHighlight() bool {
+	return a.Highlight != nil
+}
+
+// ListAnnotations returns the notes and highlights of an entry.
+func (c Client) ListAnnotations(entryID string) ([]Annotation, error) {
+	params := url.Values{}
+	params.Set("entryId", entryID)
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + annotationsEndpoint + "?" + params.Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var annotations []Annotation
+	err = json.Unmarshal(body, &annotations)
+	if err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+// CreateOrUpdateAnnotationRequest encapsulates the request payload for the CreateAnnotation and UpdateAnnotation methods.
+type CreateOrUpdateAnnotationRequest struct {
+	// EntryID string the id of the annotated entry; required by CreateAnnotation, ignored by UpdateAnnotation.
+	EntryID string `json:"entryId,omitempty"`
+	// Comment Optional string the note.
+	Comment string `json:"comment,omitempty"`
+	// Highlight Optional highlight object the highlighted text.
+	Highlight *Highlight `json:"highlight,omitempty"`
+}
+
+// CreateAnnotation adds a note and/or a highlight to an entry.
+func (c Client) CreateAnnotation(a CreateOrUpdateAnnotationRequest) (Annotation, error) {
+	if a.EntryID == "" {
+		return Annotation{}, errors.New("the entry id is required")
+	}
+	if a.Comment == "" && a.Highlight == nil {
+		return Annotation{}, errors.New("a comment or a highlight is required")
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + annotationsEndpoint
+	return c.createOrUpdateAnnotation(url, a)
+}
+
+// UpdateAnnotation updates the note and/or the highlight of an existing annotation.
+func (c Client) UpdateAnnotation(id string, a CreateOrUpdateAnnotationRequest) (Annotation, error) {
+	a.EntryID = ""
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + annotationsEndpoint + "/" + escapeID(id)
+	return c.createOrUpdateAnnotation(url, a)
+}
+
+func (c Client) createOrUpdateAnnotation(url string, a CreateOrUpdateAnnotationRequest) (Annotation, error) {
+	payload, err := json.Marshal(a)
+	if err != nil {
+		return Annotation{}, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return Annotation{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return Annotation{}, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Annotation{}, err
+	}
+	var annotation Annotation
+	err = json.Unmarshal(body, &annotation)
+	if err != nil {
+		return Annotation{}, err
+	}
+	return annotation, nil
+}
+
+// DeleteAnnotation deletes a note or highlight.
+func (c Client) DeleteAnnotation(id string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + annotationsEndpoint + "/" + escapeID(id)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
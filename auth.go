@@ -0,0 +1,70 @@
+package feedly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const authEndpoint = "auth"
+
+// Logout ends the session of the access token: the token can't be used anymore.
+// After a successful logout, any call made with this token by the Client (or a copy of it)
+// returns ErrTokenRevoked without reaching the API, see NewClient.
+func (c Client) Logout() error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + authEndpoint + "/logout"
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	c.revocations().add(c.Config.Token)
+	return nil
+}
+
+// revokeTokenRequest encapsulates the request payload for the RevokeRefreshToken method
+type revokeTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	GrantType    string `json:"grant_type"`
+}
+
+// RevokeRefreshToken revokes a refresh token, so it can't be used to get new access tokens.
+// The access tokens already issued keep working until they expire or Logout is called.
+func (c Client) RevokeRefreshToken(refreshToken, clientID, clientSecret string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + authEndpoint + "/token"
+	payload, err := json.Marshal(revokeTokenRequest{
+		RefreshToken: refreshToken,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		GrantType:    "revoke_token",
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
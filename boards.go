@@ -28,7 +28,7 @@ type Board struct {
 	// Description Optional String the board description.
 	Description string `json:"description,omitempty"`
 	// Cover Optional URL the URL of the cover image, if one was uploaded.
-	Cover url.URL `json:"cover,omitempty"`
+	Cover string `json:"cover,omitempty"`
 	// IsPublic Optional Boolean if true, this board is publicly shared.
 	IsPublic bool `json:"isPublic,omitempty"`
 	// ShowNotes Optional Boolean if true, notes are also visible to followers (public boards only).
@@ -36,17 +36,21 @@ type Board struct {
 	// ShowHighlights Optional Boolean if true, highlights are also visible to followers (public boards only).
 	ShowHighlights bool `json:"showHighlights,omitempty"`
 	// HTMLURL Optional URL the public URL for this board (public boards only).
-	HTMLURL url.URL `json:"htmlUrl,omitempty"`
+	HTMLURL string `json:"htmlUrl,omitempty"`
 	// StreamID Optional String the public feed id for this board (public boards only).
-	StreamID string `json:"streamId,omitempty"`
+	StreamID StreamID `json:"streamId,omitempty"`
 }
 
 // ListBoards returns a list of boards. If withEnterprise is true, it returns enterprise boards
 // followed by the user as well as personal ones.
 func (c Client) ListBoards(withEnterprise bool) ([]Board, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint
+	params := url.Values{}
 	if withEnterprise {
-		url += "&withEnterprise=true"
+		params.Set("withEnterprise", "true")
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint
+	if len(params) > 0 {
+		url += "?" + params.Encode()
 	}
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -54,7 +58,7 @@ func (c Client) ListBoards(withEnterprise bool) ([]Board, error) {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -71,10 +75,10 @@ func (c Client) ListBoards(withEnterprise bool) ([]Board, error) {
 	return boards, nil
 }
 
-// UpdateBoardRequest encapsulates the request payload for the UpdateBoard method
+// UpdateBoardRequest encapsulates the request payload for the CreateBoard and UpdateBoard methods
 type UpdateBoardRequest struct {
-	// ID Optional string
-	ID string `json:"id"`
+	// ID Optional string the board id; required for UpdateBoard, ignored by CreateBoard.
+	ID string `json:"id,omitempty"`
 	// Label Optional string
 	Label string `json:"label,omitempty"`
 	// Description Optional string
@@ -89,6 +93,7 @@ type UpdateBoardRequest struct {
 
 // UpdateBoard updates a board with the data given in the request
 // Note: changing isPublic, showNotes or showHighlights requires a Feedly Pro subscription.
+// It doesn't check Board.Customizable, use EditBoard to fail before sending the request.
 func (c Client) UpdateBoard(u UpdateBoardRequest) error {
 	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint
 	payload, err := json.Marshal(u)
@@ -101,7 +106,71 @@ func (c Client) UpdateBoard(u UpdateBoardRequest) error {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// CreateBoard creates a new personal board with the data given in the request.
+// Note: setting isPublic, showNotes or showHighlights requires a Feedly Pro subscription.
+func (c Client) CreateBoard(u UpdateBoardRequest) (Board, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint
+	return c.createBoard(url, u)
+}
+
+func (c Client) createBoard(url string, u UpdateBoardRequest) (Board, error) {
+	u.ID = ""
+	payload, err := json.Marshal(u)
+	if err != nil {
+		return Board{}, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return Board{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return Board{}, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Board{}, err
+	}
+	var boards []Board
+	err = json.Unmarshal(body, &boards)
+	if err != nil {
+		return Board{}, err
+	}
+	if len(boards) == 0 {
+		return Board{}, errors.New("no board returned")
+	}
+	return boards[0], nil
+}
+
+// DeleteBoard deletes a personal board. The entries of the board are not deleted.
+// It doesn't check Board.Customizable, use RemoveBoard to fail before sending the request.
+func (c Client) DeleteBoard(id string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint + "/" + escapeID(id)
+	return c.deleteBoard(url)
+}
+
+func (c Client) deleteBoard(url string) error {
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
 	if err != nil {
 		return err
 	}
@@ -112,10 +181,32 @@ func (c Client) UpdateBoard(u UpdateBoardRequest) error {
 	return nil
 }
 
+// AddEntriesToBoard adds one or multiple entries to a board.
+// It doesn't check Board.Customizable, use AddBoardEntries to fail before sending the request.
+func (c Client) AddEntriesToBoard(boardID string, entryIDs []string) error {
+	return c.TagEntries([]StreamID{StreamID(boardID)}, entryIDs)
+}
+
+// RemoveEntriesFromBoard removes one or multiple entries from a board.
+// It doesn't check Board.Customizable, use RemoveBoardEntries to fail before sending the request.
+func (c Client) RemoveEntriesFromBoard(boardID string, entryIDs []string) error {
+	return c.UntagEntries([]StreamID{StreamID(boardID)}, entryIDs)
+}
+
+// NewBoardIterator returns an iterator over the entries of a board, the most recently added first.
+// A limit lower or equal than 0 means no limit.
+func (c Client) NewBoardIterator(boardID string, limit int) *StreamIterator {
+	return c.NewStreamIterator(StreamRequest{StreamID: StreamID(boardID)}, limit)
+}
+
 // UploadBoardCoverImage uploads a new cover image into an existing board.
+// It doesn't check Board.Customizable, use EditBoardCoverImage to fail before sending the request.
 func (c Client) UploadBoardCoverImage(id string, coverImage io.Reader) error {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint + "/" + id
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + boardsEndpoint + "/" + escapeID(id)
+	return c.uploadBoardCoverImage(url, coverImage)
+}
 
+func (c Client) uploadBoardCoverImage(url string, coverImage io.Reader) error {
 	mpm, err := newMultiPartMIME(coverImage)
 	if err != nil {
 		return err
@@ -126,7 +217,7 @@ func (c Client) UploadBoardCoverImage(id string, coverImage io.Reader) error {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", mpm.contentType)
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return err
 	}
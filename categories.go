@@ -1,6 +1,129 @@
 package feedly
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+const categoriesEndpoint = "categories"
+
+// Category stores the category data
 type Category struct {
-	ID    string `json:"id"`
-	Label string `json:"label"`
+	// ID string the category id.
+	ID StreamID `json:"id"`
+	// Label string the category label.
+	Label string `json:"label,omitempty"`
+	// Customizable Optional boolean if true, the label and description can be changed by the user.
+	Customizable bool `json:"customizable,omitempty"`
+	// Description Optional string the category description.
+	Description string `json:"description,omitempty"`
+}
+
+// ListCategories returns the user's categories.
+// If sortFeedly is true, categories are returned in the order defined in the feedly UI; otherwise they are sorted alphabetically.
+func (c Client) ListCategories(sortFeedly bool) ([]Category, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + categoriesEndpoint
+	if sortFeedly {
+		url += "?sort=feedly"
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var categories []Category
+	err = json.Unmarshal(body, &categories)
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// UpdateCategoryRequest encapsulates the request payload for the UpdateCategory method
+type UpdateCategoryRequest struct {
+	// Label Optional string the new label of the category.
+	Label string `json:"label,omitempty"`
+	// Description Optional string the new description of the category.
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateCategory changes the label and/or the description of an existing category.
+func (c Client) UpdateCategory(id StreamID, u UpdateCategoryRequest) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + categoriesEndpoint + "/" + id.PathEscape()
+	payload, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// DeleteCategory deletes a category. The feeds in this category become uncategorized.
+func (c Client) DeleteCategory(id StreamID) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + categoriesEndpoint + "/" + id.PathEscape()
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// DeleteCategories deletes multiple categories at once.
+func (c Client) DeleteCategories(ids ...StreamID) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + categoriesEndpoint + "/.mdelete"
+	payload, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("DELETE", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
 }
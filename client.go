@@ -1,9 +1,15 @@
 package feedly
 
 import (
+	"crypto/sha256"
+	"errors"
 	"net/http"
+	"sync"
 )
 
+// ErrTokenRevoked is returned by the calls made with a token revoked by Logout
+var ErrTokenRevoked = errors.New("the access token has been revoked, a new one is required")
+
 // ClientConfig stores the configuration for the Client
 type ClientConfig struct {
 	BaseURL string
@@ -17,4 +23,55 @@ type Client struct {
 	Config ClientConfig
 	// Client is the HTTP Client for make the calls
 	Client http.Client
+	// revoked stores the tokens revoked by Logout, shared by all the copies of a Client created with NewClient.
+	// The Clients created without NewClient use defaultRevokedTokens.
+	revoked *revokedTokens
+}
+
+// NewClient returns a Client with the given configuration and HTTP Client.
+// Clients created this way, and their copies, keep their own record of the tokens revoked by Logout,
+// while the Clients created as a literal share a record for the whole process.
+func NewClient(config ClientConfig, client http.Client) Client {
+	return Client{Config: config, Client: client, revoked: &revokedTokens{}}
+}
+
+// revokedTokens stores the sha256 of the revoked tokens, so the tokens themselves are not kept in memory
+type revokedTokens struct {
+	mu     sync.RWMutex
+	hashes map[[sha256.Size]byte]struct{}
+}
+
+func (r *revokedTokens) add(token string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.hashes == nil {
+		r.hashes = map[[sha256.Size]byte]struct{}{}
+	}
+	r.hashes[sha256.Sum256([]byte(token))] = struct{}{}
+}
+
+func (r *revokedTokens) contains(token string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.hashes[sha256.Sum256([]byte(token))]
+	return ok
+}
+
+// defaultRevokedTokens stores the tokens revoked by Logout on the Clients not created with NewClient
+var defaultRevokedTokens revokedTokens
+
+// revocations returns the record of the revoked tokens used by the Client
+func (c Client) revocations() *revokedTokens {
+	if c.revoked == nil {
+		return &defaultRevokedTokens
+	}
+	return c.revoked
+}
+
+// do sends the request unless the token of the Client has been revoked
+func (c Client) do(req *http.Request) (*http.Response, error) {
+	if c.revocations().contains(c.Config.Token) {
+		return nil, ErrTokenRevoked
+	}
+	return c.Client.Do(req)
 }
@@ -3,6 +3,7 @@ package feedly
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -22,7 +23,7 @@ type Collection struct {
 	// Description Optional String the description description, if defined.
 	Description string `json:"description,omitempty"`
 	// Cover Optional URL the URL of the cover image, if one was uploaded.
-	Cover url.URL `json:"cover,omitempty"`
+	Cover string `json:"cover,omitempty"`
 	// Feeds List of feeds the list of feeds in this collection.
 	Feeds []Feed `json:"feeds"`
 }
@@ -31,12 +32,16 @@ type Collection struct {
 // If withStats is true, it returns reading and tag stats for the past 31 days (default: false)
 // If withEnterprise is true, it returns enterprise collections followed by the user as well as personal ones.
 func (c Client) ListCollections(withStats, withEnterprise bool) ([]Collection, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint
+	params := url.Values{}
 	if withStats {
-		url += "&withStats=true"
+		params.Set("withStats", "true")
 	}
 	if withEnterprise {
-		url += "&withEnterprise=true"
+		params.Set("withEnterprise", "true")
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint
+	if len(params) > 0 {
+		url += "?" + params.Encode()
 	}
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -44,7 +49,7 @@ func (c Client) ListCollections(withStats, withEnterprise bool) ([]Collection, e
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -63,14 +68,14 @@ func (c Client) ListCollections(withStats, withEnterprise bool) ([]Collection, e
 
 // GetCollection returns details about a personal collection.
 func (c Client) GetCollection(id string) (Collection, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + id
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(id)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return Collection{}, err
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return Collection{}, err
 	}
@@ -90,7 +95,7 @@ func (c Client) GetCollection(id string) (Collection, error) {
 // CreateOrUpdateCollectionRequest encapsulates the request payload for the CreateCollection and UpdateCollection methods.
 type CreateOrUpdateCollectionRequest struct {
 	// Label String the unique label for this collection; required for new categories, optional when editing an existing category.
-	Label string `json:"label"`
+	Label string `json:"label,omitempty"`
 	// ID Optional String the collection id. If missing, the server will generate one (new collection).
 	ID string `json:"id,omitempty"`
 	// Description Optional String a more detailed description for this collection.
@@ -109,7 +114,7 @@ func (c Client) CreateCollection(col CreateOrUpdateCollectionRequest) (Collectio
 
 // UpdateCollection updates a personal collection.
 func (c Client) UpdateCollection(id string, col CreateOrUpdateCollectionRequest) (Collection, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + id
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(id)
 	return c.createOrUpdateCollection(url, col)
 }
 
@@ -124,7 +129,7 @@ func (c Client) createOrUpdateCollection(url string, col CreateOrUpdateCollectio
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return Collection{}, err
 	}
@@ -141,9 +146,34 @@ func (c Client) createOrUpdateCollection(url string, col CreateOrUpdateCollectio
 	return collection, nil
 }
 
+// DeleteCollection deletes a personal collection.
+func (c Client) DeleteCollection(id string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(id)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// DeleteCollectionCoverImage removes the cover image of a personal collection, leaving the rest untouched.
+func (c Client) DeleteCollectionCoverImage(id string) (Collection, error) {
+	return c.UpdateCollection(id, CreateOrUpdateCollectionRequest{DeleteCover: true})
+}
+
 // UploadCollectionCoverImage uploads a new cover image into an existing peresonal collection.
 func (c Client) UploadCollectionCoverImage(id string, coverImage io.Reader) (Collection, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + id
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(id)
 	mpm, err := newMultiPartMIME(coverImage)
 	if err != nil {
 		return Collection{}, err
@@ -154,7 +184,7 @@ func (c Client) UploadCollectionCoverImage(id string, coverImage io.Reader) (Col
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return Collection{}, err
 	}
@@ -181,7 +211,7 @@ type AddFeedRequest struct {
 
 // AddFeedToCollection adds a feed to a personal collection.
 func (c Client) AddFeedToCollection(collectionID string, f AddFeedRequest) ([]Feed, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + collectionID + "/feeds"
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(collectionID) + "/feeds"
 	payload, err := json.Marshal(f)
 	if err != nil {
 		return nil, err
@@ -192,7 +222,7 @@ func (c Client) AddFeedToCollection(collectionID string, f AddFeedRequest) ([]Fe
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +241,7 @@ func (c Client) AddFeedToCollection(collectionID string, f AddFeedRequest) ([]Fe
 
 // AddMultipleFeedToCollection adss multiple feeds to a personal collection.
 func (c Client) AddMultipleFeedToCollection(collectionID string, f []AddFeedRequest) ([]Feed, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + collectionID + "/feeds/.mput"
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(collectionID) + "/feeds/.mput"
 	payload, err := json.Marshal(f)
 	if err != nil {
 		return nil, err
@@ -222,7 +252,7 @@ func (c Client) AddMultipleFeedToCollection(collectionID string, f []AddFeedRequ
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -241,14 +271,14 @@ func (c Client) AddMultipleFeedToCollection(collectionID string, f []AddFeedRequ
 
 // DeleteFeedFromCollection renives a feed from a personal collection.
 func (c Client) DeleteFeedFromCollection(collectionID, feedID string) ([]Feed, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + collectionID + "/feeds/" + feedID
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(collectionID) + "/feeds/" + escapeID(feedID)
 	req, err := http.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +303,7 @@ type DeleteFeedRequest struct {
 
 // DeleteMultipleFeedFromCollection removes multiple feeds from a personal collection.
 func (c Client) DeleteMultipleFeedFromCollection(collectionID string, f []DeleteFeedRequest) ([]Feed, error) {
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + collectionID + "/feeds/.mdelete"
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + collectionsEndpoint + "/" + escapeID(collectionID) + "/feeds/.mdelete"
 	payload, err := json.Marshal(f)
 	if err != nil {
 		return nil, err
@@ -284,7 +314,7 @@ func (c Client) DeleteMultipleFeedFromCollection(collectionID string, f []Delete
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
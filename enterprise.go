@@ -0,0 +1,236 @@
+package feedly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const enterpriseEndpoint = "enterprise"
+
+// ErrBoardNotCustomizable is returned when trying to edit a board that cannot be changed by the user
+var ErrBoardNotCustomizable = errors.New("the board is not customizable")
+
+// TeamMember stores the data of a member of the enterprise team
+type TeamMember struct {
+	// ID string the user id.
+	ID string `json:"id"`
+	// Email Optional string the email address of the user.
+	Email string `json:"email,omitempty"`
+	// FullName Optional string the full name of the user.
+	FullName string `json:"fullName,omitempty"`
+	// Picture Optional string a picture URL for this user.
+	Picture string `json:"picture,omitempty"`
+	// Role Optional string the role of the user in the team: “admin”, “editor” or “member”.
+	Role string `json:"role,omitempty"`
+	// Created Optional timestamp the timestamp, in ms, when the user joined the team.
+	Created Time `json:"created,omitempty"`
+	// LastLogin Optional timestamp the timestamp, in ms, of the last login of the user.
+	LastLogin Time `json:"lastLogin,omitempty"`
+}
+
+// ListTeamMembers returns the members of the enterprise team of the user (team only).
+func (c Client) ListTeamMembers() ([]TeamMember, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/users"
+	return c.listTeamMembers(url)
+}
+
+// CreateEnterpriseCollection creates an enterprise collection (team admins only).
+func (c Client) CreateEnterpriseCollection(col CreateOrUpdateCollectionRequest) (Collection, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/" + collectionsEndpoint
+	return c.createOrUpdateCollection(url, col)
+}
+
+// UpdateEnterpriseCollection updates an enterprise collection (team admins only).
+func (c Client) UpdateEnterpriseCollection(id string, col CreateOrUpdateCollectionRequest) (Collection, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/" + collectionsEndpoint + "/" + escapeID(id)
+	return c.createOrUpdateCollection(url, col)
+}
+
+// CreateEnterpriseBoard creates an enterprise board (team admins only).
+func (c Client) CreateEnterpriseBoard(u UpdateBoardRequest) (Board, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/" + tagsEndpoint
+	return c.createBoard(url, u)
+}
+
+// UpdateEnterpriseBoard updates an enterprise board with the data given in the request (team admins only).
+// It doesn't check Board.Customizable, use EditBoard to fail before sending the request.
+func (c Client) UpdateEnterpriseBoard(u UpdateBoardRequest) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/" + tagsEndpoint + "/" + escapeID(u.ID)
+	payload, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// EditBoard updates a board with the data given in the request, using the enterprise endpoint for enterprise boards.
+// It returns ErrBoardNotCustomizable without sending any request if the board cannot be changed by the user.
+func (c Client) EditBoard(b Board, u UpdateBoardRequest) error {
+	if !b.Customizable {
+		return ErrBoardNotCustomizable
+	}
+	u.ID = b.ID
+	if b.Enterprise {
+		return c.UpdateEnterpriseBoard(u)
+	}
+	return c.UpdateBoard(u)
+}
+
+// EditBoardCoverImage uploads a new cover image into a board, using the enterprise endpoint for enterprise boards.
+// It returns ErrBoardNotCustomizable without sending any request if the board cannot be changed by the user.
+func (c Client) EditBoardCoverImage(b Board, coverImage io.Reader) error {
+	if !b.Customizable {
+		return ErrBoardNotCustomizable
+	}
+	if b.Enterprise {
+		url := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/" + tagsEndpoint + "/" + escapeID(b.ID)
+		return c.uploadBoardCoverImage(url, coverImage)
+	}
+	return c.UploadBoardCoverImage(b.ID, coverImage)
+}
+
+// RemoveBoard deletes a board, using the enterprise endpoint for enterprise boards.
+// It returns ErrBoardNotCustomizable without sending any request if the board cannot be changed by the user.
+func (c Client) RemoveBoard(b Board) error {
+	if !b.Customizable {
+		return ErrBoardNotCustomizable
+	}
+	if b.Enterprise {
+		return c.deleteBoard(c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/" + tagsEndpoint + "/" + escapeID(b.ID))
+	}
+	return c.DeleteBoard(b.ID)
+}
+
+// AddBoardEntries adds one or multiple entries to a board.
+// It returns ErrBoardNotCustomizable without sending any request if the board cannot be changed by the user.
+func (c Client) AddBoardEntries(b Board, entryIDs []string) error {
+	if !b.Customizable {
+		return ErrBoardNotCustomizable
+	}
+	return c.AddEntriesToBoard(b.ID, entryIDs)
+}
+
+// RemoveBoardEntries removes one or multiple entries from a board.
+// It returns ErrBoardNotCustomizable without sending any request if the board cannot be changed by the user.
+func (c Client) RemoveBoardEntries(b Board, entryIDs []string) error {
+	if !b.Customizable {
+		return ErrBoardNotCustomizable
+	}
+	return c.RemoveEntriesFromBoard(b.ID, entryIDs)
+}
+
+// enterpriseStreamURL returns the URL of an enterprise collection or board
+func (c Client) enterpriseStreamURL(streamID StreamID) (string, error) {
+	base := c.Config.BaseURL + "/" + c.Config.Version + "/" + enterpriseEndpoint + "/"
+	switch streamID.Kind() {
+	case EnterpriseCategoryStream:
+		return base + collectionsEndpoint + "/" + streamID.PathEscape(), nil
+	case EnterpriseTagStream:
+		return base + tagsEndpoint + "/" + streamID.PathEscape(), nil
+	default:
+		return "", errors.New("not an enterprise collection or board: " + string(streamID))
+	}
+}
+
+// ListFollowers returns the team members following an enterprise collection or board.
+func (c Client) ListFollowers(streamID StreamID) ([]TeamMember, error) {
+	url, err := c.enterpriseStreamURL(streamID)
+	if err != nil {
+		return nil, err
+	}
+	return c.listTeamMembers(url + "/followers")
+}
+
+// AddFollowers makes one or multiple team members follow an enterprise collection or board (team admins only).
+func (c Client) AddFollowers(streamID StreamID, userIDs []string) error {
+	url, err := c.enterpriseStreamURL(streamID)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(struct {
+		UserIDs []string `json:"userIds"`
+	}{userIDs})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("PUT", url+"/followers", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// RemoveFollowers makes one or multiple team members stop following an enterprise collection or board (team admins only).
+func (c Client) RemoveFollowers(streamID StreamID, userIDs []string) error {
+	url, err := c.enterpriseStreamURL(streamID)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("DELETE", url+"/followers/"+escapeIDs(userIDs), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+func (c Client) listTeamMembers(url string) ([]TeamMember, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var members []TeamMember
+	err = json.Unmarshal(body, &members)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
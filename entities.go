@@ -0,0 +1,106 @@
+package feedly
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const entitiesEndpoint = "entities"
+
+// Entity stores the entity data: a company, a person, a technology or a topic that can be used in the AI filters
+type Entity struct {
+	// ID string the entity id (“nlp/f/entity/...”) or topic id (“nlp/f/topic/...”).
+	ID string `json:"id"`
+	// Label string the entity name.
+	Label string `json:"label"`
+	// Type Optional string the entity type: “org”, “person”, “location”, “technology”, “topic”...
+	Type string `json:"type,omitempty"`
+	// Description Optional string a short description to tell apart entities with the same label.
+	Description string `json:"description,omitempty"`
+}
+
+// IsTopic reports whether the entity is a topic.
+func (e Entity) IsTopic() bool {
+	return strings.HasPrefix(e.ID, "nlp/f/topic/")
+}
+
+// GetEntity returns the details of an entity or topic.
+func (c Client) GetEntity(id string) (Entity, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + entitiesEndpoint + "/" + escapeID(id)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return Entity{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return Entity{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Entity{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Entity{}, err
+	}
+	var entity Entity
+	err = json.Unmarshal(body, &entity)
+	if err != nil {
+		return Entity{}, err
+	}
+	return entity, nil
+}
+
+// SearchEntities finds the entities and topics matching a human-readable name.
+// count is the number of results (default: 20) and locale a hint to the search engine (e.g. “en”); both are optional.
+func (c Client) SearchEntities(query string, count int, locale string) ([]Entity, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	if count > 0 {
+		params.Set("count", strconv.Itoa(count))
+	}
+	if locale != "" {
+		params.Set("locale", locale)
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + searchEndpoint + "/" + entitiesEndpoint + "?" + params.Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var results struct {
+		Entities []Entity `json:"entities"`
+	}
+	err = json.Unmarshal(body, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results.Entities, nil
+}
+
+// MatchesFilter returns a “matches” filter on the given entities and topics, to be used as a Priority layer.
+// salience is optional: “about” if the entries should be about the entities, “mention” if they just mention them.
+func MatchesFilter(salience string, entities ...Entity) Filter {
+	parts := make([]string, len(entities))
+	for i, e := range entities {
+		parts[i] = e.ID
+	}
+	return Filter{Type: "matches", Parts: parts, Salience: salience}
+}
@@ -6,9 +6,14 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"sort"
 )
 
-const entriesEndpoint = "entries"
+const (
+	entriesEndpoint = "entries"
+	// maxEntryIDs is the maximum number of entry ids accepted by the .mget endpoint
+	maxEntryIDs = 1000
+)
 
 // Content stores the content data
 type Content struct {
@@ -29,7 +34,7 @@ type Link struct {
 // Origin stores the origin feed from which this article was crawled data
 type Origin struct {
 	// StreamID	string contain the feed id
-	StreamID string `json:"streamId,omitempty"`
+	StreamID StreamID `json:"streamId,omitempty"`
 	// Title string the feed title
 	Title string `json:"title,omitempty"`
 	// HTMLURL string the feed's website
@@ -96,19 +101,25 @@ type Entry struct {
 	SID string `json:"sid,omitempty"`
 	// Priorities Optional priority object array a list of priority filters that match this entry (pro+ and team only).
 	Priorities []Priority `json:"priorities,omitempty"`
+	// Annotations Optional annotation object array the notes and highlights the user added to this entry.
+	Annotations []Annotation `json:"annotations,omitempty"`
+	// ShortURL Optional string a short URL to share this entry. It is not returned by the API, see AddShortURL.
+	ShortURL string `json:"shortUrl,omitempty"`
+	// Vulnerabilities Optional vulnerability object array the vulnerabilities (CVE) mentioned by this entry (threat intelligence only).
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
 }
 
 // GetEntry returns the content of an entry
 func (c Client) GetEntry(id string) (Entry, error) {
 	var entry Entry
-	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + entriesEndpoint + "/" + id
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + entriesEndpoint + "/" + escapeID(id)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return entry, err
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return entry, err
 	}
@@ -127,7 +138,7 @@ func (c Client) GetEntry(id string) (Entry, error) {
 // ListEntries returns the content for a dynamic list of entries.
 // The number of entry ids you can pass as an input is limited to 1,000.
 func (c Client) ListEntries(ids []string) ([]Entry, error) {
-	if len(ids) > 1000 {
+	if len(ids) > maxEntryIDs {
 		return nil, errors.New("The number of entry ids you can pass as an input is limited to 1,000.")
 	}
 	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + entriesEndpoint + "/.mget"
@@ -141,7 +152,7 @@ func (c Client) ListEntries(ids []string) ([]Entry, error) {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +169,44 @@ func (c Client) ListEntries(ids []string) ([]Entry, error) {
 	return entries, nil
 }
 
+// HydrateEntries returns the content for any number of entry ids, calling ListEntries
+// in batches of at most 1,000 ids.
+func (c Client) HydrateEntries(ids []string) ([]Entry, error) {
+	entries := make([]Entry, 0, len(ids))
+	for start := 0; start < len(ids); start += maxEntryIDs {
+		end := start + maxEntryIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch, err := c.ListEntries(ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, batch...)
+	}
+	return entries, nil
+}
+
+// SortEntriesByEngagement sorts the entries by engagement, the most popular first.
+// Entries with the same engagement keep their original order.
+func SortEntriesByEngagement(entries []Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Engagement > entries[j].Engagement
+	})
+}
+
+// TopEntriesByEngagement returns the n most popular entries, sorted by engagement.
+// The given slice is not modified.
+func TopEntriesByEngagement(entries []Entry, n int) []Entry {
+	sorted := make([]Entry, len(entries))
+	copy(sorted, entries)
+	SortEntriesByEngagement(sorted)
+	if n >= 0 && n < len(sorted) {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
 // CreateEntryRequest encapsulates the request payload for the CreateEntry method
 type CreateEntryRequest struct {
 	// Title string the article’s title. This string does not contain any HTML markup.
@@ -195,7 +244,7 @@ func (c Client) CreateEntry(cer CreateEntryRequest) (Entry, error) {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return entry, err
 	}
@@ -21,16 +21,16 @@ func main() {
 	if token == "" {
 		log.Fatal("ACCESS_TOKEN env var missing")
 	}
-	cli := feedly.Client{
-		Config: feedly.ClientConfig{
+	cli := feedly.NewClient(
+		feedly.ClientConfig{
 			BaseURL: baseURL,
 			Version: version,
 			Token:   token,
 		},
-		Client: http.Client{
+		http.Client{
 			Timeout: 20 * time.Second,
 		},
-	}
+	)
 	resp, err := cli.GetProfile()
 	if err != nil {
 		log.Fatal(err)
@@ -1,27 +1,106 @@
 package feedly
 
-import "net/url"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+const feedsEndpoint = "feeds"
 
 // Feed stores the feed data
 type Feed struct {
 	// ID string the unique, immutable id of this feed.
-	ID string
+	ID StreamID `json:"id"`
 	// FeedID string same as id; for backward compatibility
-	FeedID string
+	FeedID StreamID `json:"feedId,omitempty"`
 	// Subscribers integer number of Feedly Cloud subscribers who have this feed in their subscription list.
-	Subscribers int
+	Subscribers int `json:"subscribers,omitempty"`
 	// Title string the feed name.
-	Title string
+	Title string `json:"title,omitempty"`
 	// Description Optional string the feed description.
-	Description string
+	Description string `json:"description,omitempty"`
 	// Language Optional string this field is a combination of the language reported by the RSS feed, and the language automatically detected from the feed’s content. It might not be accurate, as many feeds misreport it.
-	Language string
+	Language string `json:"language,omitempty"`
 	// Velocity Optional float the average number of articles published weekly. This number is updated every few days.
-	Velocity float64
+	Velocity float64 `json:"velocity,omitempty"`
 	// Website Optional url the website for this feed.
-	Website url.URL
+	Website string `json:"website,omitempty"`
 	// Topics Optional string array an array of topics this feed covers. This list can be used in searches and mixes to build a list of related feeds and articles. E.g. if the list contains “productivity”, querying “productivity” in feed search will produce a list of related feeds.
-	Topics []string
+	Topics []string `json:"topics,omitempty"`
 	// State Optional string only returned if the feed cannot be polled. Values include “dead” (cannot be polled), “dead.flooded” (if the feed produces too many articles per day), “dead.dropped” (if the feed has been removed), and “dormant” (if the feed hasn’t been updated in a few months).
-	State string
+	State string `json:"state,omitempty"`
+	// IconURL Optional string the auto-detected icon URL of this feed (small).
+	IconURL string `json:"iconUrl,omitempty"`
+	// VisualURL Optional string the auto-detected “visual” URL of this feed (large).
+	VisualURL string `json:"visualUrl,omitempty"`
+	// CoverURL Optional string the auto-detected cover image URL of this feed.
+	CoverURL string `json:"coverUrl,omitempty"`
+	// CoverColor Optional string the dominant color of the cover image, as an hex string.
+	CoverColor string `json:"coverColor,omitempty"`
+	// Partial Optional boolean true if the feed only provides partial content (a summary) instead of the full articles.
+	Partial bool `json:"partial,omitempty"`
+	// ContentType Optional string the type of content of this feed: “longform”, “article”, “video” or “audio”.
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// GetFeed returns the metadata of a single feed.
+func (c Client) GetFeed(id StreamID) (Feed, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + feedsEndpoint + "/" + id.PathEscape()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return Feed{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return Feed{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Feed{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Feed{}, err
+	}
+	var feed Feed
+	err = json.Unmarshal(body, &feed)
+	if err != nil {
+		return Feed{}, err
+	}
+	return feed, nil
+}
+
+// ListFeeds returns the metadata for a list of feeds.
+func (c Client) ListFeeds(ids []StreamID) ([]Feed, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + feedsEndpoint + "/.mget"
+	payload, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var feeds []Feed
+	err = json.Unmarshal(body, &feeds)
+	if err != nil {
+		return nil, err
+	}
+	return feeds, nil
 }
@@ -0,0 +1,266 @@
+package feedly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const markersEndpoint = "markers"
+
+// Marker actions
+const (
+	markAsRead     = "markAsRead"
+	keepUnread     = "keepUnread"
+	undoMarkAsRead = "undoMarkAsRead"
+)
+
+// markerRequest encapsulates the request payload for the markers endpoint
+type markerRequest struct {
+	// Action string “markAsRead”, “keepUnread” or “undoMarkAsRead”.
+	Action string `json:"action"`
+	// Type string “entries”, “feeds” or “categories”.
+	Type string `json:"type"`
+	// EntryIDs Optional string array the entry ids, for the “entries” type.
+	EntryIDs []string `json:"entryIds,omitempty"`
+	// FeedIDs Optional string array the feed ids, for the “feeds” type.
+	FeedIDs []StreamID `json:"feedIds,omitempty"`
+	// CategoryIDs Optional string array the category ids, for the “categories” type.
+	CategoryIDs []StreamID `json:"categoryIds,omitempty"`
+	// AsOf Optional timestamp entries older than this timestamp are marked as read.
+	AsOf *Time `json:"asOf,omitempty"`
+	// LastReadEntryID Optional string entries older than this entry are marked as read.
+	LastReadEntryID string `json:"lastReadEntryId,omitempty"`
+}
+
+// MarkEntriesAsRead marks one or multiple entries as read.
+func (c Client) MarkEntriesAsRead(entryIDs ...string) error {
+	return c.mark(markerRequest{Action: markAsRead, Type: "entries", EntryIDs: entryIDs})
+}
+
+// KeepEntriesUnread keeps one or multiple entries as unread.
+func (c Client) KeepEntriesUnread(entryIDs ...string) error {
+	return c.mark(markerRequest{Action: keepUnread, Type: "entries", EntryIDs: entryIDs})
+}
+
+// MarkFeedsAsRead marks the entries of one or multiple feeds as read.
+// Only the entries older than asOf, or older than the lastReadEntryID entry, are marked; one of them is required.
+func (c Client) MarkFeedsAsRead(feedIDs []StreamID, asOf Time, lastReadEntryID string) error {
+	m := markerRequest{Action: markAsRead, Type: "feeds", FeedIDs: feedIDs}
+	if err := m.setAsOf(asOf, lastReadEntryID); err != nil {
+		return err
+	}
+	return c.mark(m)
+}
+
+// MarkCategoriesAsRead marks the entries of one or multiple categories as read.
+// Only the entries older than asOf, or older than the lastReadEntryID entry, are marked; one of them is required.
+func (c Client) MarkCategoriesAsRead(categoryIDs []StreamID, asOf Time, lastReadEntryID string) error {
+	m := markerRequest{Action: markAsRead, Type: "categories", CategoryIDs: categoryIDs}
+	if err := m.setAsOf(asOf, lastReadEntryID); err != nil {
+		return err
+	}
+	return c.mark(m)
+}
+
+// UndoMarkFeedsAsRead undoes the last mark as read operation on one or multiple feeds.
+func (c Client) UndoMarkFeedsAsRead(feedIDs ...StreamID) error {
+	return c.mark(markerRequest{Action: undoMarkAsRead, Type: "feeds", FeedIDs: feedIDs})
+}
+
+// UndoMarkCategoriesAsRead undoes the last mark as read operation on one or multiple categories.
+func (c Client) UndoMarkCategoriesAsRead(categoryIDs ...StreamID) error {
+	return c.mark(markerRequest{Action: undoMarkAsRead, Type: "categories", CategoryIDs: categoryIDs})
+}
+
+// setAsOf sets the limit of a mark as read operation on feeds or categories
+func (m *markerRequest) setAsOf(asOf Time, lastReadEntryID string) error {
+	if asOf.IsZero() && lastReadEntryID == "" {
+		return errors.New("asOf or lastReadEntryId is required")
+	}
+	if !asOf.IsZero() {
+		m.AsOf = &asOf
+	}
+	m.LastReadEntryID = lastReadEntryID
+	return nil
+}
+
+func (c Client) mark(m markerRequest) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + markersEndpoint
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// UnreadCount stores the unread count data of a stream
+type UnreadCount struct {
+	// ID string the feed, category or global stream id.
+	ID StreamID `json:"id"`
+	// Count integer the number of unread entries.
+	Count int `json:"count"`
+	// Updated timestamp the timestamp, in ms, of the newest unread entry.
+	Updated Time `json:"updated"`
+}
+
+// UnreadCountsRequest encapsulates the query parameters for the GetUnreadCounts method
+type UnreadCountsRequest struct {
+	// AutoRefresh Optional boolean lets the server know if this is a background auto-refresh or not. In case of very high load on the service, the server can deny access to background requests and give priority to user facing operations.
+	AutoRefresh bool
+	// NewerThan Optional timestamp timestamp used for consistency checking; if the unread counts haven't changed since this time, the response is empty.
+	NewerThan Time
+	// StreamID Optional string a user or system category can be passed to restrict the unread count response to feeds in this category.
+	StreamID StreamID
+}
+
+// GetUnreadCounts returns the list of unread counts per feed, category and global stream.
+func (c Client) GetUnreadCounts(r UnreadCountsRequest) ([]UnreadCount, error) {
+	params := url.Values{}
+	if r.AutoRefresh {
+		params.Set("autorefresh", "true")
+	}
+	if !r.NewerThan.IsZero() {
+		params.Set("newerThan", r.NewerThan.millis())
+	}
+	if r.StreamID != "" {
+		params.Set("streamId", string(r.StreamID))
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + markersEndpoint + "/counts"
+	if len(params) > 0 {
+		url += "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var counts struct {
+		UnreadCounts []UnreadCount `json:"unreadcounts"`
+	}
+	err = json.Unmarshal(body, &counts)
+	if err != nil {
+		return nil, err
+	}
+	return counts.UnreadCounts, nil
+}
+
+// ReadMarker stores the data of a feed or category marked as read
+type ReadMarker struct {
+	// ID string the feed or category id.
+	ID StreamID `json:"id"`
+	// AsOf timestamp the entries older than this timestamp were marked as read.
+	AsOf Time `json:"asOf"`
+}
+
+// LatestReads stores the read operations done since a timestamp
+type LatestReads struct {
+	// Entries string array the ids of the entries marked as read.
+	Entries []string `json:"entries"`
+	// Unread Optional string array the ids of the entries kept unread.
+	Unread []string `json:"unread,omitempty"`
+	// Feeds Optional read marker array the feeds marked as read.
+	Feeds []ReadMarker `json:"feeds,omitempty"`
+	// Categories Optional read marker array the categories marked as read.
+	Categories []ReadMarker `json:"categories,omitempty"`
+	// Updated Optional timestamp the timestamp, in ms, to use as newerThan in the next sync.
+	Updated Time `json:"updated,omitempty"`
+}
+
+// GetLatestReads returns the entries, feeds and categories read since newerThan.
+// This is useful to keep a local copy of the read state in sync.
+func (c Client) GetLatestReads(newerThan Time) (LatestReads, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + markersEndpoint + "/reads"
+	if !newerThan.IsZero() {
+		url += "?newerThan=" + newerThan.millis()
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return LatestReads{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return LatestReads{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return LatestReads{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return LatestReads{}, err
+	}
+	var reads LatestReads
+	err = json.Unmarshal(body, &reads)
+	if err != nil {
+		return LatestReads{}, err
+	}
+	return reads, nil
+}
+
+// GetLatestTaggedEntries returns the ids of the entries tagged since newerThan, grouped by tag id.
+// This is useful to keep a local copy of the tags in sync.
+func (c Client) GetLatestTaggedEntries(newerThan Time) (map[StreamID][]string, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + markersEndpoint + "/tags"
+	if !newerThan.IsZero() {
+		url += "?newerThan=" + newerThan.millis()
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var tagged struct {
+		TaggedEntries map[StreamID][]string `json:"taggedEntries"`
+	}
+	err = json.Unmarshal(body, &tagged)
+	if err != nil {
+		return nil, err
+	}
+	return tagged.TaggedEntries, nil
+}
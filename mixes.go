@@ -0,0 +1,61 @@
+package feedly
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const mixesEndpoint = "mixes"
+
+// GetMix returns a mix of the most engaging content available in a stream (a feed, a category or a topic).
+// count is the number of entries to return (default: 3), hours the number of hours of content to consider (default: 24)
+// and locale a hint to return entries in that locale; all are optional. If backfill is true, lower quality entries are
+// added when there aren't enough popular ones. If unreadOnly is true, only unread entries are returned.
+func (c Client) GetMix(streamID StreamID, count, hours int, backfill bool, locale string, unreadOnly bool) ([]Entry, error) {
+	params := url.Values{}
+	params.Set("streamId", string(streamID))
+	if count > 0 {
+		params.Set("count", strconv.Itoa(count))
+	}
+	if hours > 0 {
+		params.Set("hours", strconv.Itoa(hours))
+	}
+	if backfill {
+		params.Set("backfill", "true")
+	}
+	if locale != "" {
+		params.Set("locale", locale)
+	}
+	if unreadOnly {
+		params.Set("unreadOnly", "true")
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + mixesEndpoint + "/contents?" + params.Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var sc StreamContents
+	err = json.Unmarshal(body, &sc)
+	if err != nil {
+		return nil, err
+	}
+	return sc.Items, nil
+}
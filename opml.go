@@ -0,0 +1,225 @@
+package feedly
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+)
+
+const opmlEndpoint = "opml"
+
+// OPML stores the data of an OPML document
+type OPML struct {
+	XMLName xml.Name `xml:"opml"`
+	// Version string the OPML version.
+	Version string `xml:"version,attr"`
+	// Head the head of the document.
+	Head OPMLHead `xml:"head"`
+	// Body the body of the document.
+	Body OPMLBody `xml:"body"`
+}
+
+// OPMLHead stores the head of an OPML document
+type OPMLHead struct {
+	// Title Optional string the title of the document.
+	Title string `xml:"title,omitempty"`
+}
+
+// OPMLBody stores the body of an OPML document
+type OPMLBody struct {
+	// Outlines outline array the top level outlines: folders or feeds.
+	Outlines []Outline `xml:"outline"`
+}
+
+// Outline stores an OPML outline: a folder if it contains other outlines, a feed if it has an XMLURL
+type Outline struct {
+	// Text string the text of the outline.
+	Text string `xml:"text,attr"`
+	// Title Optional string the title of the outline.
+	Title string `xml:"title,attr,omitempty"`
+	// Type Optional string “rss” for feeds.
+	Type string `xml:"type,attr,omitempty"`
+	// XMLURL Optional string the URL of the feed.
+	XMLURL string `xml:"xmlUrl,attr,omitempty"`
+	// HTMLURL Optional string the website of the feed.
+	HTMLURL string `xml:"htmlUrl,attr,omitempty"`
+	// Outlines Optional outline array the children of a folder.
+	Outlines []Outline `xml:"outline,omitempty"`
+}
+
+// IsFeed reports whether the outline is a feed.
+func (o Outline) IsFeed() bool {
+	return o.XMLURL != ""
+}
+
+// label returns the title of the outline, or the text if missing
+func (o Outline) label() string {
+	if o.Title != "" {
+		return o.Title
+	}
+	return o.Text
+}
+
+// Feed returns the feed described by the outline.
+func (o Outline) Feed() Feed {
+	id := FeedStreamID(o.XMLURL)
+	return Feed{ID: id, FeedID: id, Title: o.label(), Website: o.HTMLURL}
+}
+
+// feedOutline returns the outline describing the feed
+func feedOutline(f Feed) Outline {
+	xmlURL := f.ID.Name()
+	if f.ID.Kind() != FeedStream {
+		xmlURL = string(f.ID)
+	}
+	return Outline{Text: f.Title, Title: f.Title, Type: "rss", XMLURL: xmlURL, HTMLURL: f.Website}
+}
+
+// ParseOPML reads an OPML document.
+func ParseOPML(r io.Reader) (OPML, error) {
+	var o OPML
+	err := xml.NewDecoder(r).Decode(&o)
+	if err != nil {
+		return OPML{}, err
+	}
+	return o, nil
+}
+
+// Write writes the OPML document, including the XML header.
+func (o OPML) Write(w io.Writer) error {
+	if o.Version == "" {
+		o.Version = "1.0"
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(o); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// NewOPML returns an OPML document with a folder per collection containing its feeds.
+// The feeds of collections without label are placed outside any folder.
+func NewOPML(title string, collections []Collection) OPML {
+	o := OPML{Version: "1.0", Head: OPMLHead{Title: title}}
+	for _, col := range collections {
+		if col.Label == "" {
+			for _, f := range col.Feeds {
+				o.Body.Outlines = append(o.Body.Outlines, feedOutline(f))
+			}
+			continue
+		}
+		folder := Outline{Text: col.Label, Title: col.Label}
+		for _, f := range col.Feeds {
+			folder.Outlines = append(folder.Outlines, feedOutline(f))
+		}
+		o.Body.Outlines = append(o.Body.Outlines, folder)
+	}
+	return o
+}
+
+// Collections returns a collection per folder of the document containing the feeds directly inside it.
+// Feeds outside any folder are returned in a collection without label.
+func (o OPML) Collections() []Collection {
+	var collections []Collection
+	uncategorized := Collection{}
+	var walk func(outlines []Outline)
+	walk = func(outlines []Outline) {
+		for _, ol := range outlines {
+			if ol.IsFeed() {
+				continue
+			}
+			col := Collection{Label: ol.label()}
+			for _, child := range ol.Outlines {
+				if child.IsFeed() {
+					col.Feeds = append(col.Feeds, child.Feed())
+				}
+			}
+			collections = append(collections, col)
+			walk(ol.Outlines)
+		}
+	}
+	for _, ol := range o.Body.Outlines {
+		if ol.IsFeed() {
+			uncategorized.Feeds = append(uncategorized.Feeds, ol.Feed())
+		}
+	}
+	walk(o.Body.Outlines)
+	if len(uncategorized.Feeds) > 0 {
+		collections = append(collections, uncategorized)
+	}
+	return collections
+}
+
+// Subscriptions returns a subscription per feed of the document, with a category per folder containing it.
+// The category ids are built from the folder labels, so the categories can be used in a SubscribeRequest.
+func (o OPML) Subscriptions() []Subscription {
+	var subscriptions []Subscription
+	index := map[StreamID]int{}
+	var walk func(outlines []Outline, folder string)
+	walk = func(outlines []Outline, folder string) {
+		for _, ol := range outlines {
+			if !ol.IsFeed() {
+				walk(ol.Outlines, ol.label())
+				continue
+			}
+			f := ol.Feed()
+			i, ok := index[f.ID]
+			if !ok {
+				i = len(subscriptions)
+				index[f.ID] = i
+				subscriptions = append(subscriptions, Subscription{Feed: f})
+			}
+			if folder != "" {
+				subscriptions[i].Categories = append(subscriptions[i].Categories, Category{ID: CategoryStreamID(CurrentUserID, folder), Label: folder})
+			}
+		}
+	}
+	walk(o.Body.Outlines, "")
+	return subscriptions
+}
+
+// ExportOPML writes the user's subscriptions as an OPML document.
+func (c Client) ExportOPML(w io.Writer) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + opmlEndpoint
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// ImportOPML imports an OPML document into the user's subscriptions.
+func (c Client) ImportOPML(r io.Reader) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + opmlEndpoint
+	req, err := http.NewRequest("POST", url, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "text/xml")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package feedly
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+const preferencesEndpoint = "preferences"
+
+// DeletePreference is the value that deletes a preference when passed to UpdatePreferences
+const DeletePreference = "==DELETE=="
+
+// Preferences stores the preferences of the user. The values are stored as strings by Feedly,
+// use the typed accessors to read and set them.
+type Preferences map[string]string
+
+// String returns the value of the preference and whether it is set.
+func (p Preferences) String(key string) (string, bool) {
+	v, ok := p[key]
+	return v, ok
+}
+
+// Bool returns the value of the preference as a boolean and whether it is set and is a boolean.
+func (p Preferences) Bool(key string) (bool, bool) {
+	v, ok := p[key]
+	if !ok {
+		return false, false
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, false
+	}
+	return b, true
+}
+
+// Int returns the value of the preference as an integer and whether it is set and is an integer.
+func (p Preferences) Int(key string) (int, bool) {
+	v, ok := p[key]
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// SetString sets the value of the preference.
+func (p Preferences) SetString(key, value string) {
+	p[key] = value
+}
+
+// SetBool sets the value of the preference as a boolean.
+func (p Preferences) SetBool(key string, value bool) {
+	p[key] = strconv.FormatBool(value)
+}
+
+// SetInt sets the value of the preference as an integer.
+func (p Preferences) SetInt(key string, value int) {
+	p[key] = strconv.Itoa(value)
+}
+
+// Delete marks the preference to be deleted by UpdatePreferences.
+func (p Preferences) Delete(key string) {
+	p[key] = DeletePreference
+}
+
+// GetPreferences returns the preferences of the logged user.
+func (c Client) GetPreferences() (Preferences, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + preferencesEndpoint
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var p Preferences
+	err = json.Unmarshal(body, &p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UpdatePreferences creates, updates or deletes (see Preferences.Delete) the given preferences.
+// The preferences not included are left untouched. It returns the updated preferences.
+func (c Client) UpdatePreferences(u Preferences) (Preferences, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + preferencesEndpoint
+	payload, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var p Preferences
+	err = json.Unmarshal(body, &p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
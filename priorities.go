@@ -1,11 +1,24 @@
 package feedly
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const prioritiesEndpoint = "priorities"
+
 // Filter stores the filter data
 type Filter struct {
 	// Type filter type “matches” for entity/topic/phrases filtering; “likeBoard” for like-board filtering; “security” for vulnerability severity filtering
 	Type string `json:"type"`
 	// Parts array of ids an array of ids of the relevant type (“parts” for “matches”, “boards” for “likeBoard”, “severities” for “security”)
-	Parts []string `json:"parts"`
+	Parts []string `json:"parts,omitempty"`
+	// Severities Optional array of severities the vulnerability severities, for “security” type only.
+	Severities []Severity `json:"severities,omitempty"`
 	// Salience Optional about or mention for “entities” type only; indicate if the entries should be about the entities, or just mention the entities.
 	Salience string `json:"salience,omitempty"`
 }
@@ -19,7 +32,7 @@ type Priority struct {
 	// Layers list of filters the search filters used to find entries in the selected category or feed.
 	Layers []Filter `json:"filters"`
 	// StreamIDs list of category ids the personal category id on which this priority filter applies. Only one category is allowed.
-	StreamIDs []string `json:"streamIds"`
+	StreamIDs []StreamID `json:"streamIds"`
 	// Active Optional boolean is the importance filter active? (default: true).
 	Active bool `json:"active,omitempty"`
 	// ActiveUntil Optional timestamp time limit for this importance filter. After this date, the importance filter will not be refreshed.
@@ -35,3 +48,133 @@ type Priority struct {
 	// NumEntriesMatching Optional number the number of entries that were prioritized by this filter over the past week.
 	NumEntriesMatching int `json:"numEntriesMatching,omitempty"`
 }
+
+// ListPriorities returns the priority filters of the user.
+// If streamID is not empty, only the priority filters applying to that category are returned.
+func (c Client) ListPriorities(streamID StreamID) ([]Priority, error) {
+	params := url.Values{}
+	if streamID != "" {
+		params.Set("streamId", string(streamID))
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + prioritiesEndpoint
+	if len(params) > 0 {
+		url += "?" + params.Encode()
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var priorities []Priority
+	err = json.Unmarshal(body, &priorities)
+	if err != nil {
+		return nil, err
+	}
+	return priorities, nil
+}
+
+// CreateOrUpdatePriorityRequest encapsulates the request payload for the CreatePriority and UpdatePriority methods.
+type CreateOrUpdatePriorityRequest struct {
+	// ID Optional string the priority filter id; required for UpdatePriority, ignored by CreatePriority.
+	ID string `json:"id,omitempty"`
+	// Label string the priority filter label.
+	Label string `json:"label"`
+	// Layers list of filters the search filters used to find entries in the selected category.
+	Layers []Filter `json:"filters"`
+	// StreamIDs list of category ids the personal category id on which this priority filter applies. Only one category is allowed.
+	StreamIDs []StreamID `json:"streamIds"`
+	// Active Optional boolean is the importance filter active? (default: true).
+	Active *bool `json:"active,omitempty"`
+	// ActiveUntil Optional timestamp time limit for this importance filter. After this date, the importance filter will not be refreshed.
+	ActiveUntil *Time `json:"activeUntil,omitempty"`
+}
+
+// validate checks the request before sending it
+func (p CreateOrUpdatePriorityRequest) validate() error {
+	if len(p.StreamIDs) != 1 {
+		return errors.New("a priority filter applies to exactly one category")
+	}
+	if p.StreamIDs[0].Kind() != CategoryStream {
+		return errors.New("a priority filter applies to a personal category, got a " + p.StreamIDs[0].Kind().String() + " stream id")
+	}
+	return nil
+}
+
+// CreatePriority creates a priority filter on a personal category (pro+ and team only).
+func (c Client) CreatePriority(p CreateOrUpdatePriorityRequest) (Priority, error) {
+	p.ID = ""
+	return c.createOrUpdatePriority(p)
+}
+
+// UpdatePriority updates an existing priority filter (pro+ and team only).
+func (c Client) UpdatePriority(p CreateOrUpdatePriorityRequest) (Priority, error) {
+	if p.ID == "" {
+		return Priority{}, errors.New("the priority filter id is required")
+	}
+	return c.createOrUpdatePriority(p)
+}
+
+func (c Client) createOrUpdatePriority(p CreateOrUpdatePriorityRequest) (Priority, error) {
+	if err := p.validate(); err != nil {
+		return Priority{}, err
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + prioritiesEndpoint
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return Priority{}, err
+	}
+	req, err := http.NewRequest("PUT", url, bytes.NewReader(payload))
+	if err != nil {
+		return Priority{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return Priority{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Priority{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Priority{}, err
+	}
+	var priority Priority
+	err = json.Unmarshal(body, &priority)
+	if err != nil {
+		return Priority{}, err
+	}
+	return priority, nil
+}
+
+// DeletePriority deletes a priority filter.
+func (c Client) DeletePriority(id string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + prioritiesEndpoint + "/" + escapeID(id)
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
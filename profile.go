@@ -71,7 +71,7 @@ func (c Client) GetProfile() (Profile, error) {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return Profile{}, err
 	}
@@ -121,7 +121,7 @@ func (c Client) UpdateProfile(u UpdateProfileRequest) (Profile, error) {
 	}
 	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
 	req.Header.Add("Content-Type", "application/json")
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return Profile{}, err
 	}
@@ -0,0 +1,155 @@
+package feedly
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const searchEndpoint = "search"
+
+// FeedSearchResult stores a feed found by SearchFeeds
+type FeedSearchResult struct {
+	Feed
+	// Score Optional float the relevance of this feed for the search query.
+	Score float64 `json:"score,omitempty"`
+	// Coverage Optional float the percentage of the articles of this feed related to the search query.
+	Coverage float64 `json:"coverage,omitempty"`
+	// CoverageScore Optional float the coverage combined with the relevance of this feed.
+	CoverageScore float64 `json:"coverageScore,omitempty"`
+	// EstimatedEngagement Optional integer an estimation of how popular the articles of this feed are.
+	EstimatedEngagement int `json:"estimatedEngagement,omitempty"`
+	// LastUpdated Optional timestamp the timestamp, in ms, of the last article of this feed.
+	LastUpdated Time `json:"lastUpdated,omitempty"`
+	// DeliciousTags Optional string array the topics related to this feed.
+	DeliciousTags []string `json:"deliciousTags,omitempty"`
+}
+
+// FeedSearch stores the response of SearchFeeds
+type FeedSearch struct {
+	// Hint Optional string the query used by the search engine, if it was rewritten.
+	Hint string `json:"hint,omitempty"`
+	// Related Optional string array the topics related to the search query.
+	Related []string `json:"related,omitempty"`
+	// Results feed search result array the feeds matching the search query.
+	Results []FeedSearchResult `json:"results"`
+}
+
+// SearchFeeds finds feeds based on title, URL or #topic.
+// count is the number of results (default: 20) and locale a hint to the search engine to return feeds in that locale (e.g. “pt”, “fr_FR”); both are optional.
+func (c Client) SearchFeeds(query string, count int, locale string) (FeedSearch, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	if count > 0 {
+		params.Set("count", strconv.Itoa(count))
+	}
+	if locale != "" {
+		params.Set("locale", locale)
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + searchEndpoint + "/feeds?" + params.Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return FeedSearch{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return FeedSearch{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return FeedSearch{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return FeedSearch{}, err
+	}
+	var fs FeedSearch
+	err = json.Unmarshal(body, &fs)
+	if err != nil {
+		return FeedSearch{}, err
+	}
+	return fs, nil
+}
+
+// SearchStreamOptions encapsulates the optional query parameters for the SearchStream method
+type SearchStreamOptions struct {
+	// Count Optional number number of entries to return. default is 10. max is 20.
+	Count int
+	// NewerThan Optional timestamp return only the entries newer than this timestamp.
+	NewerThan Time
+	// Continuation Optional string a continuation id is used to page through the search results.
+	Continuation string
+	// UnreadOnly Optional boolean if true, return unread entries only.
+	UnreadOnly bool
+	// Fields Optional string array the fields to search on: “title”, “author”, “keywords”. Default is all.
+	Fields []string
+	// Embedded Optional string only return entries embedding this type of media: “audio”, “video”, “doc” or “any”.
+	Embedded string
+	// Engagement Optional string only return entries with this minimum engagement: “medium” or “high”.
+	Engagement string
+	// Locale Optional string a hint to the search engine to return entries in that locale (e.g. “pt”, “fr_FR”).
+	Locale string
+}
+
+// SearchStream searches the entries of a stream (a feed, a category or a tag) matching the query.
+// The Continuation of the result can be set in the options to get the next page.
+func (c Client) SearchStream(streamID StreamID, query string, o SearchStreamOptions) (StreamContents, error) {
+	params := url.Values{}
+	params.Set("streamId", string(streamID))
+	params.Set("query", query)
+	if o.Count > 0 {
+		params.Set("count", strconv.Itoa(o.Count))
+	}
+	if !o.NewerThan.IsZero() {
+		params.Set("newerThan", o.NewerThan.millis())
+	}
+	if o.Continuation != "" {
+		params.Set("continuation", o.Continuation)
+	}
+	if o.UnreadOnly {
+		params.Set("unreadOnly", "true")
+	}
+	if len(o.Fields) > 0 {
+		params.Set("fields", strings.Join(o.Fields, ","))
+	}
+	if o.Embedded != "" {
+		params.Set("embedded", o.Embedded)
+	}
+	if o.Engagement != "" {
+		params.Set("engagement", o.Engagement)
+	}
+	if o.Locale != "" {
+		params.Set("locale", o.Locale)
+	}
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + searchEndpoint + "/contents?" + params.Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return StreamContents{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	var sc StreamContents
+	err = json.Unmarshal(body, &sc)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	return sc, nil
+}
@@ -0,0 +1,65 @@
+package feedly
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+const shortenEndpoint = "shorten"
+
+// ShortURL stores the short URL data of an entry
+type ShortURL struct {
+	// URL string the short URL of the entry.
+	URL string `json:"shortUrl"`
+	// Expires Optional timestamp the timestamp, in ms, when the short URL expires.
+	Expires Time `json:"expires,omitempty"`
+}
+
+// ShortenEntry returns a short URL to share an entry.
+func (c Client) ShortenEntry(entryID string) (ShortURL, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + shortenEndpoint + "/" + entriesEndpoint + "/" + escapeID(entryID)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return ShortURL{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return ShortURL{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ShortURL{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return ShortURL{}, err
+	}
+	var s ShortURL
+	err = json.Unmarshal(body, &s)
+	if err != nil {
+		return ShortURL{}, err
+	}
+	return s, nil
+}
+
+// AddShortURL returns the entry with its ShortURL set, ready to be exported or shared.
+// If the entry already has a short URL, it is returned untouched.
+// It returns an error if Feedly doesn't return a short URL for the entry.
+func (c Client) AddShortURL(e Entry) (Entry, error) {
+	if e.ShortURL != "" {
+		return e, nil
+	}
+	s, err := c.ShortenEntry(e.ID)
+	if err != nil {
+		return e, err
+	}
+	if s.URL == "" {
+		return e, errors.New("no short URL returned for the entry: " + e.ID)
+	}
+	e.ShortURL = s.URL
+	return e, nil
+}
@@ -0,0 +1,195 @@
+package feedly
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Global names used by the global categories and tags of a user
+const (
+	// GlobalAll the category containing all the feeds of the user.
+	GlobalAll = "global.all"
+	// GlobalUncategorized the category containing the feeds without category.
+	GlobalUncategorized = "global.uncategorized"
+	// GlobalMust the category containing the feeds marked as must read.
+	GlobalMust = "global.must"
+	// GlobalSaved the tag containing the entries saved for later.
+	GlobalSaved = "global.saved"
+	// GlobalRead the tag containing the entries explicitly marked as read.
+	GlobalRead = "global.read"
+)
+
+// CurrentUserID can be used instead of the user id in the stream ids to refer to the logged user
+const CurrentUserID = "-"
+
+// StreamKind classifies the stream ids
+type StreamKind int
+
+// Kinds of stream ids
+const (
+	// UnknownStream an id that doesn't follow any of the known formats.
+	UnknownStream StreamKind = iota
+	// FeedStream a feed id: feed/<url>
+	FeedStream
+	// CategoryStream a personal category id: user/<userId>/category/<label>
+	CategoryStream
+	// TagStream a personal tag id: user/<userId>/tag/<label>
+	TagStream
+	// EnterpriseCategoryStream an enterprise category id: enterprise/<enterpriseId>/category/<id>
+	EnterpriseCategoryStream
+	// EnterpriseTagStream an enterprise tag id: enterprise/<enterpriseId>/tag/<id>
+	EnterpriseTagStream
+)
+
+// String implements the fmt.Stringer interface
+func (k StreamKind) String() string {
+	switch k {
+	case FeedStream:
+		return "feed"
+	case CategoryStream:
+		return "category"
+	case TagStream:
+		return "tag"
+	case EnterpriseCategoryStream:
+		return "enterprise category"
+	case EnterpriseTagStream:
+		return "enterprise tag"
+	default:
+		return "unknown"
+	}
+}
+
+// StreamID is the id of a stream: a feed, a category or a tag, personal or enterprise.
+type StreamID string
+
+// FeedStreamID returns the stream id of the feed with the given URL.
+func FeedStreamID(feedURL string) StreamID {
+	return StreamID("feed/" + feedURL)
+}
+
+// CategoryStreamID returns the stream id of a personal category of the user.
+func CategoryStreamID(userID, label string) StreamID {
+	return StreamID("user/" + userID + "/category/" + label)
+}
+
+// TagStreamID returns the stream id of a personal tag of the user.
+func TagStreamID(userID, label string) StreamID {
+	return StreamID("user/" + userID + "/tag/" + label)
+}
+
+// GlobalCategoryStreamID returns the stream id of a global category of the user (e.g. GlobalAll).
+func GlobalCategoryStreamID(userID, name string) StreamID {
+	return CategoryStreamID(userID, name)
+}
+
+// GlobalTagStreamID returns the stream id of a global tag of the user (e.g. GlobalSaved).
+func GlobalTagStreamID(userID, name string) StreamID {
+	return TagStreamID(userID, name)
+}
+
+// EnterpriseCategoryStreamID returns the stream id of an enterprise category.
+func EnterpriseCategoryStreamID(enterpriseID, id string) StreamID {
+	return StreamID("enterprise/" + enterpriseID + "/category/" + id)
+}
+
+// EnterpriseTagStreamID returns the stream id of an enterprise tag.
+func EnterpriseTagStreamID(enterpriseID, id string) StreamID {
+	return StreamID("enterprise/" + enterpriseID + "/tag/" + id)
+}
+
+// ParseStreamID validates and classifies an arbitrary stream id.
+// It returns an error if the id doesn't follow any of the known formats.
+func ParseStreamID(id string) (StreamID, error) {
+	s := StreamID(id)
+	if s.Kind() == UnknownStream {
+		return "", errors.New("unknown stream id format: " + id)
+	}
+	return s, nil
+}
+
+// split returns the kind, the owner (user or enterprise id) and the name of the stream id
+func (s StreamID) split() (StreamKind, string, string) {
+	id := string(s)
+	if strings.HasPrefix(id, "feed/") {
+		if len(id) == len("feed/") {
+			return UnknownStream, "", ""
+		}
+		return FeedStream, "", strings.TrimPrefix(id, "feed/")
+	}
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+		return UnknownStream, "", ""
+	}
+	switch parts[0] + "/" + parts[2] {
+	case "user/category":
+		return CategoryStream, parts[1], parts[3]
+	case "user/tag":
+		return TagStream, parts[1], parts[3]
+	case "enterprise/category":
+		return EnterpriseCategoryStream, parts[1], parts[3]
+	case "enterprise/tag":
+		return EnterpriseTagStream, parts[1], parts[3]
+	}
+	return UnknownStream, "", ""
+}
+
+// Kind returns the kind of the stream id.
+func (s StreamID) Kind() StreamKind {
+	k, _, _ := s.split()
+	return k
+}
+
+// Owner returns the user id or the enterprise id owning the stream. It is empty for feeds.
+func (s StreamID) Owner() string {
+	_, owner, _ := s.split()
+	return owner
+}
+
+// Name returns the label of a category or tag, or the URL of a feed.
+func (s StreamID) Name() string {
+	_, _, name := s.split()
+	return name
+}
+
+// IsGlobal reports whether the stream id is a global category or tag of the user (e.g. GlobalSaved).
+func (s StreamID) IsGlobal() bool {
+	k, _, name := s.split()
+	return (k == CategoryStream || k == TagStream) && strings.HasPrefix(name, "global.")
+}
+
+// PathEscape escapes the stream id so it can be safely placed inside a URL path segment.
+func (s StreamID) PathEscape() string {
+	return escapeID(string(s))
+}
+
+// String implements the fmt.Stringer interface
+func (s StreamID) String() string {
+	return string(s)
+}
+
+// escapeID escapes an id (stream, entry, board...) so it can be placed inside a URL path segment.
+// Besides the characters escaped by url.PathEscape, “:” and “+” are escaped too as Feedly expects.
+func escapeID(id string) string {
+	return idEscaper.Replace(url.PathEscape(id))
+}
+
+var idEscaper = strings.NewReplacer(":", "%3A", "+", "%2B")
+
+// escapeIDs escapes multiple ids and joins them with commas, as expected by the endpoints accepting a list of ids in the path.
+func escapeIDs(ids []string) string {
+	escaped := make([]string, len(ids))
+	for i, id := range ids {
+		escaped[i] = escapeID(id)
+	}
+	return strings.Join(escaped, ",")
+}
+
+// streamIDStrings converts a list of stream ids into a list of strings
+func streamIDStrings(ids []StreamID) []string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = string(id)
+	}
+	return s
+}
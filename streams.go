@@ -0,0 +1,209 @@
+package feedly
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const streamsEndpoint = "streams"
+
+// StreamContents stores the stream contents data
+type StreamContents struct {
+	// ID string the stream id.
+	ID string `json:"id"`
+	// Title Optional string the stream title.
+	Title string `json:"title,omitempty"`
+	// Direction Optional string “ltr” for left-to-right, “rtl” for right-to-left
+	Direction string `json:"direction,omitempty"`
+	// Updated Optional timestamp the timestamp, in ms, of the last update of the stream.
+	Updated Time `json:"updated,omitempty"`
+	// Alternate Optional link object array a list of alternate links for this stream.
+	Alternate []Link `json:"alternate,omitempty"`
+	// Continuation Optional string the continuation id to pass to the next stream call, for pagination. This id guarantees that no entry will be duplicated in a stream (meaning, there is no need to de-duplicate entries returned by this call). If this value is not returned, it means the end of the stream has been reached.
+	Continuation string `json:"continuation,omitempty"`
+	// Items entry array the entries of the stream.
+	Items []Entry `json:"items"`
+}
+
+// StreamRequest encapsulates the query parameters for the GetStreamContents and GetStreamIDs methods
+type StreamRequest struct {
+	// StreamID string a feedId, a categoryId, a tagId or a system category id.
+	StreamID StreamID
+	// Count Optional number number of entries (or entry ids) to return. default is 20. max is 1000 for contents and 10,000 for ids.
+	Count int
+	// Ranked Optional string newest or oldest. if “newest”, the entries are returned with the newest first (default: newest).
+	Ranked string
+	// UnreadOnly Optional boolean if true, return unread entries only.
+	UnreadOnly bool
+	// NewerThan Optional timestamp return only the entries newer than this timestamp.
+	NewerThan Time
+	// Continuation Optional string a continuation id is used to page through the content.
+	Continuation string
+}
+
+// values returns the query parameters of the request
+func (r StreamRequest) values() url.Values {
+	params := url.Values{}
+	params.Set("streamId", string(r.StreamID))
+	if r.Count > 0 {
+		params.Set("count", strconv.Itoa(r.Count))
+	}
+	if r.Ranked != "" {
+		params.Set("ranked", r.Ranked)
+	}
+	if r.UnreadOnly {
+		params.Set("unreadOnly", "true")
+	}
+	if !r.NewerThan.IsZero() {
+		params.Set("newerThan", r.NewerThan.millis())
+	}
+	if r.Continuation != "" {
+		params.Set("continuation", r.Continuation)
+	}
+	return params
+}
+
+// GetStreamContents returns the content of a stream (a feed, a category or a tag).
+func (c Client) GetStreamContents(r StreamRequest) (StreamContents, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + streamsEndpoint + "/contents?" + r.values().Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return StreamContents{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	var sc StreamContents
+	err = json.Unmarshal(body, &sc)
+	if err != nil {
+		return StreamContents{}, err
+	}
+	return sc, nil
+}
+
+// StreamIDs stores the stream entry ids data
+type StreamIDs struct {
+	// IDs string array the entry ids of the stream.
+	IDs []string `json:"ids"`
+	// Continuation Optional string the continuation id to pass to the next stream call, for pagination. If this value is not returned, it means the end of the stream has been reached.
+	Continuation string `json:"continuation,omitempty"`
+}
+
+// GetStreamIDs returns a page of entry ids of a stream (a feed, a category or a tag).
+func (c Client) GetStreamIDs(r StreamRequest) (StreamIDs, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + streamsEndpoint + "/ids?" + r.values().Encode()
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return StreamIDs{}, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return StreamIDs{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return StreamIDs{}, errors.New(http.StatusText(resp.StatusCode))
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return StreamIDs{}, err
+	}
+	var ids StreamIDs
+	err = json.Unmarshal(body, &ids)
+	if err != nil {
+		return StreamIDs{}, err
+	}
+	return ids, nil
+}
+
+// ListAllStreamIDs pages through the entry ids of a stream following the continuation ids
+// until the stream is exhausted or limit ids have been returned.
+// A limit lower or equal than 0 means no limit.
+func (c Client) ListAllStreamIDs(r StreamRequest, limit int) ([]string, error) {
+	var ids []string
+	for {
+		page, err := c.GetStreamIDs(r)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, page.IDs...)
+		if limit > 0 && len(ids) >= limit {
+			return ids[:limit], nil
+		}
+		if page.Continuation == "" {
+			return ids, nil
+		}
+		r.Continuation = page.Continuation
+	}
+}
+
+// StreamIterator follows the continuation ids of a stream returning its entries one by one.
+type StreamIterator struct {
+	c     Client
+	req   StreamRequest
+	limit int
+	items []Entry
+	entry Entry
+	seen  int
+	done  bool
+	err   error
+}
+
+// NewStreamIterator returns an iterator over the entries of the stream given in the request.
+// The iteration stops when the stream is exhausted or limit entries have been returned.
+// A limit lower or equal than 0 means no limit.
+func (c Client) NewStreamIterator(r StreamRequest, limit int) *StreamIterator {
+	return &StreamIterator{c: c, req: r, limit: limit}
+}
+
+// Next advances the iterator to the next entry, fetching a new page when needed.
+// It returns false when the iteration stops, either by reaching the end of the stream, the limit or an error.
+func (it *StreamIterator) Next() bool {
+	if it.err != nil || (it.limit > 0 && it.seen >= it.limit) {
+		return false
+	}
+	for len(it.items) == 0 {
+		if it.done {
+			return false
+		}
+		sc, err := it.c.GetStreamContents(it.req)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.items = sc.Items
+		it.req.Continuation = sc.Continuation
+		it.done = sc.Continuation == ""
+	}
+	it.entry = it.items[0]
+	it.items = it.items[1:]
+	it.seen++
+	return true
+}
+
+// Entry returns the current entry of the iterator.
+func (it *StreamIterator) Entry() Entry {
+	return it.entry
+}
+
+// Err returns the first error found during the iteration, if any.
+func (it *StreamIterator) Err() error {
+	return it.err
+}
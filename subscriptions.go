@@ -0,0 +1,118 @@
+package feedly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+const subscriptionsEndpoint = "subscriptions"
+
+// Subscription stores the subscription data: a feed in the user's subscription list
+type Subscription struct {
+	Feed
+	// Added Optional timestamp the timestamp, in ms, when this feed was added to the subscription list.
+	Added Time `json:"added,omitempty"`
+	// Updated Optional timestamp the timestamp, in ms, when this feed was last updated.
+	Updated Time `json:"updated,omitempty"`
+	// SortID Optional string the sort id of this subscription, used to order the feeds inside a category.
+	SortID string `json:"sortid,omitempty"`
+	// Categories category object array a list of category objects (“id” and “label”) that the user associated with this feed.
+	Categories []Category `json:"categories"`
+}
+
+// ListSubscriptions returns the user's subscriptions.
+func (c Client) ListSubscriptions() ([]Subscription, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + subscriptionsEndpoint
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var subscriptions []Subscription
+	err = json.Unmarshal(body, &subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// SubscribeRequest encapsulates the request payload for the Subscribe and UpdateSubscriptions methods
+type SubscribeRequest struct {
+	// ID string the feed id.
+	ID StreamID `json:"id"`
+	// Title Optional string the feed title; if missing, the default feed title will be used.
+	Title string `json:"title,omitempty"`
+	// Categories Optional category object array the categories of this subscription. If missing, the feed will be uncategorized. Existing categories only need the “id”, new ones need the “label” too.
+	Categories []Category `json:"categories,omitempty"`
+}
+
+// Subscribe adds a feed to the user's subscriptions, or updates an existing subscription.
+func (c Client) Subscribe(s SubscribeRequest) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + subscriptionsEndpoint
+	payload, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return c.subscribe(url, payload)
+}
+
+// UpdateSubscriptions adds or updates multiple subscriptions at once.
+func (c Client) UpdateSubscriptions(s []SubscribeRequest) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + subscriptionsEndpoint + "/.mput"
+	payload, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return c.subscribe(url, payload)
+}
+
+func (c Client) subscribe(url string, payload []byte) error {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// Unsubscribe removes a feed from the user's subscriptions.
+func (c Client) Unsubscribe(feedID StreamID) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + subscriptionsEndpoint + "/" + feedID.PathEscape()
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
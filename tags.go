@@ -1,6 +1,147 @@
 package feedly
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+)
+
+const tagsEndpoint = "tags"
+
+// Tag stores the tag data
 type Tag struct {
-	ID    string `json:"id"`
+	// ID string the tag id.
+	ID StreamID `json:"id"`
+	// Label string the tag label.
 	Label string `json:"label"`
+	// Description Optional string the tag description.
+	Description string `json:"description,omitempty"`
+}
+
+// ListTags returns the user's tags.
+func (c Client) ListTags() ([]Tag, error) {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + tagsEndpoint
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var tags []Tag
+	err = json.Unmarshal(body, &tags)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// TagEntries adds one or multiple tags to one or multiple entries.
+// Tags that don't exist yet are created.
+func (c Client) TagEntries(tagIDs []StreamID, entryIDs []string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + tagsEndpoint + "/" + escapeIDs(streamIDStrings(tagIDs))
+	payload, err := json.Marshal(struct {
+		EntryIDs []string `json:"entryIds"`
+	}{entryIDs})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("PUT", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// UntagEntries removes one or multiple tags from one or multiple entries.
+func (c Client) UntagEntries(tagIDs []StreamID, entryIDs []string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + tagsEndpoint + "/" + escapeIDs(streamIDStrings(tagIDs)) + "/" + escapeIDs(entryIDs)
+	return c.deleteTags(url)
+}
+
+// RenameTag changes the label of an existing tag.
+func (c Client) RenameTag(tagID StreamID, label string) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + tagsEndpoint + "/" + tagID.PathEscape()
+	payload, err := json.Marshal(struct {
+		Label string `json:"label"`
+	}{label})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// DeleteTags deletes one or multiple tags. The tagged entries are untagged.
+func (c Client) DeleteTags(tagIDs ...StreamID) error {
+	url := c.Config.BaseURL + "/" + c.Config.Version + "/" + tagsEndpoint + "/" + escapeIDs(streamIDStrings(tagIDs))
+	return c.deleteTags(url)
+}
+
+func (c Client) deleteTags(url string) error {
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.Config.Token)
+	req.Header.Add("Content-Type", "application/json")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(http.StatusText(resp.StatusCode))
+	}
+	return nil
+}
+
+// SaveForLater saves one or multiple entries for later by tagging them with the global.saved tag.
+func (c Client) SaveForLater(entryIDs ...string) error {
+	return c.TagEntries([]StreamID{GlobalTagStreamID(CurrentUserID, GlobalSaved)}, entryIDs)
+}
+
+// Unsave removes one or multiple entries from the saved for later list.
+func (c Client) Unsave(entryIDs ...string) error {
+	return c.UntagEntries([]StreamID{GlobalTagStreamID(CurrentUserID, GlobalSaved)}, entryIDs)
+}
+
+// NewSavedIterator returns an iterator over the entries saved for later, the most recently saved first.
+// The ActionTimestamp of the entries contains the timestamp when they were saved.
+// A limit lower or equal than 0 means no limit.
+func (c Client) NewSavedIterator(limit int) *StreamIterator {
+	return c.NewStreamIterator(StreamRequest{StreamID: GlobalTagStreamID(CurrentUserID, GlobalSaved)}, limit)
 }
@@ -2,6 +2,7 @@ package feedly
 
 import (
 	"encoding/json"
+	"strconv"
 	"time"
 )
 
@@ -25,3 +26,13 @@ func (t *Time) UnmarshalJSON(b []byte) error {
 	}
 	return nil
 }
+
+// IsZero reports whether t represents the zero time instant
+func (t Time) IsZero() bool {
+	return time.Time(t).IsZero()
+}
+
+// millis returns the timestamp in ms formatted for a query parameter
+func (t Time) millis() string {
+	return strconv.FormatInt(time.Time(t).Unix()*1000, 10)
+}
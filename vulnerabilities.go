@@ -0,0 +1,116 @@
+package feedly
+
+// Severity is the severity of a vulnerability, as used by the “security” filters
+type Severity string
+
+// Severities of the vulnerabilities, based on the CVSS score
+const (
+	// SeverityUnknown the vulnerability has no CVSS score.
+	SeverityUnknown Severity = ""
+	// SeverityLow CVSS score lower than 4.
+	SeverityLow Severity = "low"
+	// SeverityMedium CVSS score from 4 to 6.9.
+	SeverityMedium Severity = "medium"
+	// SeverityHigh CVSS score from 7 to 8.9.
+	SeverityHigh Severity = "high"
+	// SeverityCritical CVSS score from 9 to 10.
+	SeverityCritical Severity = "critical"
+)
+
+// rank returns the position of the severity, the higher the more severe
+func (s Severity) rank() int {
+	switch s {
+	case SeverityLow:
+		return 1
+	case SeverityMedium:
+		return 2
+	case SeverityHigh:
+		return 3
+	case SeverityCritical:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// ExploitStatus is the exploitation status of a vulnerability
+type ExploitStatus string
+
+// Exploitation statuses of the vulnerabilities
+const (
+	// ExploitUnknown no exploit is known.
+	ExploitUnknown ExploitStatus = ""
+	// ExploitProofOfConcept a proof of concept of the exploit has been published.
+	ExploitProofOfConcept ExploitStatus = "proofOfConcept"
+	// ExploitExploited the vulnerability is being exploited in the wild.
+	ExploitExploited ExploitStatus = "exploited"
+)
+
+// Vulnerability stores the vulnerability (CVE) data attached to an entry
+type Vulnerability struct {
+	// ID string the CVE id, e.g. “CVE-2021-44228”.
+	ID string `json:"id"`
+	// Label Optional string the vulnerability name.
+	Label string `json:"label,omitempty"`
+	// Description Optional string the vulnerability description.
+	Description string `json:"description,omitempty"`
+	// CVSSScore Optional float the CVSS score, from 0 to 10.
+	CVSSScore float64 `json:"cvssScore,omitempty"`
+	// Vendor Optional string the vendor of the vulnerable product.
+	Vendor string `json:"vendor,omitempty"`
+	// Product Optional string the vulnerable product.
+	Product string `json:"product,omitempty"`
+	// ExploitStatus Optional string the exploitation status of the vulnerability.
+	ExploitStatus ExploitStatus `json:"exploitStatus,omitempty"`
+	// Published Optional timestamp the timestamp, in ms, when the vulnerability was published.
+	Published Time `json:"published,omitempty"`
+}
+
+// Severity returns the severity of the vulnerability from its CVSS score.
+// It returns SeverityUnknown if the vulnerability has not been scored.
+func (v Vulnerability) Severity() Severity {
+	switch {
+	case v.CVSSScore <= 0:
+		return SeverityUnknown
+	case v.CVSSScore >= 9:
+		return SeverityCritical
+	case v.CVSSScore >= 7:
+		return SeverityHigh
+	case v.CVSSScore >= 4:
+		return SeverityMedium
+	default:
+		return SeverityLow
+	}
+}
+
+// IsExploited reports whether the vulnerability is being exploited in the wild.
+func (v Vulnerability) IsExploited() bool {
+	return v.ExploitStatus == ExploitExploited
+}
+
+// VulnerabilitiesAtLeast returns the vulnerabilities of the entry with a severity greater or equal than min.
+// The vulnerabilities without CVSS score are never returned, nor is anything returned for an unknown min.
+func (e Entry) VulnerabilitiesAtLeast(min Severity) []Vulnerability {
+	if min.rank() == 0 {
+		return nil
+	}
+	var vulnerabilities []Vulnerability
+	for _, v := range e.Vulnerabilities {
+		if r := v.Severity().rank(); r > 0 && r >= min.rank() {
+			vulnerabilities = append(vulnerabilities, v)
+		}
+	}
+	return vulnerabilities
+}
+
+// SecurityFilter returns a “security” filter on the given severities, to be used as a Priority layer.
+// The unknown severities, like SeverityUnknown, are left out of the filter.
+func SecurityFilter(severities ...Severity) Filter {
+	var known []Severity
+	for _, s := range severities {
+		if s.rank() > 0 {
+			known = append(known, s)
+		}
+	}
+	return Filter{Type: "security", Severities: known}
+}